@@ -0,0 +1,60 @@
+package base
+
+import (
+	"os"
+	"strings"
+)
+
+// DefaultFileMode is the permission generated files are written with
+// when FileOptions.Mode is left zero.
+const DefaultFileMode = os.FileMode(0644)
+
+// FileOptions describes a single generated file: which project it
+// belongs to, where it must be written and whether it should carry the
+// standard header comment.
+type FileOptions struct {
+	ProjectOptions
+	HeaderComment bool
+	Name          string
+
+	// Mode is the permission the file is written with. Zero means
+	// DefaultFileMode; set it explicitly for files that must be
+	// executable, such as maintainer scripts or debian/rules.
+	Mode os.FileMode
+}
+
+// FileTemplate renders the contents of a generated file.
+type FileTemplate interface {
+	Render() (string, error)
+}
+
+// FileInfo pairs a FileTemplate with the FileOptions describing where it
+// must be written.
+type FileInfo struct {
+	FileOptions
+	FileTemplate FileTemplate
+}
+
+// Build renders the file's template and writes it to disk.
+func (f FileInfo) Build() error {
+	content, err := f.FileTemplate.Render()
+	if err != nil {
+		return err
+	}
+
+	mode := f.Mode
+	if mode == 0 {
+		mode = DefaultFileMode
+	}
+
+	return os.WriteFile(f.Name, []byte(content), mode)
+}
+
+// AddExtension appends ext to name unless name already ends with it.
+func AddExtension(name, ext string) string {
+	if strings.HasSuffix(name, ext) {
+		return name
+	}
+
+	return name + ext
+}
@@ -0,0 +1,79 @@
+package base
+
+// Project is implemented by every project kind the generator knows how
+// to scaffold (application, library, ...). Build materializes the
+// in-memory file set onto disk.
+type Project interface {
+	String() string
+	Build() error
+}
+
+// Build systems a project's Makefile/CMakeLists.txt can be generated
+// for. BuildSystemBoth emits both a Makefile and a CMakeLists.txt.
+const (
+	BuildSystemMake  = "make"
+	BuildSystemCMake = "cmake"
+	BuildSystemBoth  = "both"
+)
+
+// Native package formats a project can be packaged for.
+const (
+	PackageFormatDeb  = "deb"
+	PackageFormatApk  = "apk"
+	PackageFormatRpm  = "rpm"
+	PackageFormatArch = "arch"
+)
+
+// DebianMetadata carries the fields needed to render a real
+// debian/control (and friends) file tree instead of bare maintainer
+// script skeletons.
+type DebianMetadata struct {
+	Maintainer       string
+	Section          string
+	Priority         string
+	Depends          []string
+	BuildDepends     []string
+	Homepage         string
+	ShortDescription string
+	LongDescription  string
+	StandardsVersion string
+}
+
+// ProjectOptions carries the user-facing knobs collected by the CLI and
+// threaded through every project kind and template.
+type ProjectOptions struct {
+	ProjectName    string
+	Author         string
+	Email          string
+	PackageProject bool
+
+	// BuildSystem selects which build file(s) are generated alongside
+	// the project sources. Defaults to BuildSystemMake when empty.
+	BuildSystem string
+
+	// PackageFormats selects which native package formats pkg_install
+	// is populated for. Defaults to []string{PackageFormatDeb} when
+	// empty, preserving the historical Debian-only behaviour.
+	PackageFormats []string
+
+	// TargetArchitectures selects which architectures pkg_install is
+	// laid out for (e.g. "amd64", "i386", "armhf", "arm64"). Defaults
+	// to []string{"amd64"} when empty.
+	TargetArchitectures []string
+
+	// Debian carries the metadata used to render debian/control and
+	// its companion files when PackageFormatDeb is enabled.
+	Debian DebianMetadata
+
+	// License is the SPDX identifier (e.g. "MIT", "GPL-3.0-only") the
+	// project is released under. Optional: when empty, no LICENSE file
+	// is generated and the License fields of debian/copyright, the RPM
+	// spec and the PKGBUILD are rendered empty. When set, it is
+	// validated against the SPDX license list by each project kind's
+	// New().
+	License string
+
+	// Copyright holds the copyright notice line(s) embedded in the
+	// generated LICENSE file and package metadata.
+	Copyright []string
+}
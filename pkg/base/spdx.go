@@ -0,0 +1,47 @@
+package base
+
+import "fmt"
+
+// spdxLicenses is the subset of the SPDX license list
+// (https://spdx.org/licenses/) this generator knows how to validate
+// against and render a LICENSE file for.
+var spdxLicenses = map[string]bool{
+	"MIT":               true,
+	"Apache-2.0":        true,
+	"GPL-2.0-only":      true,
+	"GPL-2.0-or-later":  true,
+	"GPL-3.0-only":      true,
+	"GPL-3.0-or-later":  true,
+	"LGPL-2.1-only":     true,
+	"LGPL-2.1-or-later": true,
+	"LGPL-3.0-only":     true,
+	"LGPL-3.0-or-later": true,
+	"AGPL-3.0-only":     true,
+	"AGPL-3.0-or-later": true,
+	"BSD-2-Clause":      true,
+	"BSD-3-Clause":      true,
+	"MPL-2.0":           true,
+	"ISC":               true,
+	"Unlicense":         true,
+	"CC0-1.0":           true,
+}
+
+// IsValidSPDXLicense reports whether id is a recognized SPDX license
+// identifier.
+func IsValidSPDXLicense(id string) bool {
+	return spdxLicenses[id]
+}
+
+// ValidateSPDXLicense returns an error describing why id is not a usable
+// license identifier, or nil if id is recognized.
+func ValidateSPDXLicense(id string) error {
+	if id == "" {
+		return fmt.Errorf("license identifier is required")
+	}
+
+	if !IsValidSPDXLicense(id) {
+		return fmt.Errorf("%q is not a recognized SPDX license identifier", id)
+	}
+
+	return nil
+}
@@ -5,15 +5,45 @@ import (
 	"os"
 
 	"source-template/pkg/base"
+	"source-template/pkg/project"
 	"source-template/pkg/project/common"
 	"source-template/pkg/templates"
 )
 
+// kindName is this package's registration name with the project
+// registry, and the key New looks itself back up under to validate
+// options against the Fields it advertised.
+const kindName = "application"
+
+// kindFields documents the base.ProjectOptions fields this project kind
+// understands, so the registry can reject options it doesn't advertise.
+var kindFields = []project.Field{
+	{Name: "BuildSystem", Description: "Build file(s) to generate: make, cmake or both"},
+	{Name: "PackageFormats", Description: "Native package formats to lay out pkg_install for: deb, apk, rpm, arch"},
+	{Name: "TargetArchitectures", Description: "Architectures to lay out pkg_install for"},
+	{Name: "Debian", Description: "Debian control file metadata"},
+	{Name: "License", Description: "SPDX license identifier"},
+	{Name: "Copyright", Description: "Copyright notice line(s) for LICENSE and package metadata"},
+}
+
+func init() {
+	project.Register(project.Kind{
+		Name:   kindName,
+		New:    New,
+		Fields: kindFields,
+	})
+}
+
 type Application struct {
 	sources  []base.FileInfo
 	headers  []base.FileInfo
 	debian   []base.FileInfo
+	apk      []base.FileInfo
+	rpm      []base.FileInfo
+	arch     []base.FileInfo
 	misc     []base.FileInfo
+	cmake    *base.FileInfo
+	makefile *base.FileInfo
 	rootPath string
 	base.ProjectOptions
 }
@@ -22,14 +52,62 @@ func (a Application) String() string {
 	return fmt.Sprintf("Application project")
 }
 
+// packageFormats returns the native package formats a project should be
+// packaged for, defaulting to Debian-only when none were requested.
+func packageFormats(options base.ProjectOptions) []string {
+	if len(options.PackageFormats) == 0 {
+		return []string{base.PackageFormatDeb}
+	}
+
+	return options.PackageFormats
+}
+
+func hasFormat(formats []string, format string) bool {
+	for _, f := range formats {
+		if f == format {
+			return true
+		}
+	}
+
+	return false
+}
+
+// packageFormatDir maps a package format to the directory name it is
+// laid out under inside pkg_install/<arch>/, preserving the historical
+// "debian" directory name for the deb format.
+func packageFormatDir(format string) string {
+	if format == base.PackageFormatDeb {
+		return "debian"
+	}
+
+	return format
+}
+
+// targetArchitectures returns the architectures a project's pkg_install
+// tree should be laid out for, defaulting to amd64-only when none were
+// requested.
+func targetArchitectures(options base.ProjectOptions) []string {
+	if len(options.TargetArchitectures) == 0 {
+		return []string{"amd64"}
+	}
+
+	return options.TargetArchitectures
+}
+
 func createApplicationDirtree(path string, options base.ProjectOptions) error {
 	var subdirs []string
 	var prefix string
 
 	if options.PackageProject {
 		prefix = options.ProjectName
-		subdirs = append(subdirs, "pkg_install/misc")
-		subdirs = append(subdirs, "pkg_install/debian")
+
+		for _, arch := range targetArchitectures(options) {
+			subdirs = append(subdirs, "pkg_install/"+arch+"/misc")
+
+			for _, format := range packageFormats(options) {
+				subdirs = append(subdirs, "pkg_install/"+arch+"/"+packageFormatDir(format))
+			}
+		}
 	}
 
 	subdirs = append(subdirs, prefix+"/src")
@@ -66,6 +144,13 @@ func (a Application) Build() error {
 		}
 	}
 
+	// create misc root files (LICENSE, ...)
+	for _, f := range a.misc {
+		if err := f.Build(); err != nil {
+			return err
+		}
+	}
+
 	// create debian scripts
 	for _, f := range a.debian {
 		if err := f.Build(); err != nil {
@@ -73,7 +158,40 @@ func (a Application) Build() error {
 		}
 	}
 
-	// create Makefile (future CMakeLists.txt)
+	// create apk scripts
+	for _, f := range a.apk {
+		if err := f.Build(); err != nil {
+			return err
+		}
+	}
+
+	// create rpm spec
+	for _, f := range a.rpm {
+		if err := f.Build(); err != nil {
+			return err
+		}
+	}
+
+	// create arch PKGBUILD
+	for _, f := range a.arch {
+		if err := f.Build(); err != nil {
+			return err
+		}
+	}
+
+	// create CMakeLists.txt, if requested
+	if a.cmake != nil {
+		if err := a.cmake.Build(); err != nil {
+			return err
+		}
+	}
+
+	// create Makefile, if requested
+	if a.makefile != nil {
+		if err := a.makefile.Build(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -127,15 +245,82 @@ func createHeaders(options base.ProjectOptions, rootPath string, prefix string)
 	return files
 }
 
+func createCMake(options base.ProjectOptions, sources []base.FileInfo, rootPath string, prefix string, archs []string) *base.FileInfo {
+	if options.BuildSystem != base.BuildSystemCMake && options.BuildSystem != base.BuildSystemBoth {
+		return nil
+	}
+
+	fileOptions := base.FileOptions{
+		ProjectOptions: options,
+		Name:           rootPath + "/CMakeLists.txt",
+	}
+
+	return &base.FileInfo{
+		FileOptions:  fileOptions,
+		FileTemplate: templates.NewCMake(options, sources, rootPath, prefix, archs),
+	}
+}
+
+func createMakefile(options base.ProjectOptions, sources []base.FileInfo, rootPath string, prefix string, archs []string) *base.FileInfo {
+	if options.BuildSystem != base.BuildSystemMake && options.BuildSystem != base.BuildSystemBoth {
+		return nil
+	}
+
+	fileOptions := base.FileOptions{
+		ProjectOptions: options,
+		Name:           rootPath + "/Makefile",
+	}
+
+	return &base.FileInfo{
+		FileOptions:  fileOptions,
+		FileTemplate: templates.NewMakefile(options, sources, rootPath, prefix, archs),
+	}
+}
+
+func createLicense(options base.ProjectOptions, rootPath string) []base.FileInfo {
+	if options.License == "" {
+		return nil
+	}
+
+	fileOptions := base.FileOptions{
+		ProjectOptions: options,
+		Name:           rootPath + "/LICENSE",
+	}
+
+	return []base.FileInfo{
+		{
+			FileOptions:  fileOptions,
+			FileTemplate: templates.NewLicense(options),
+		},
+	}
+}
+
 func New(options base.ProjectOptions) (base.Project, error) {
 	var rootPath string
 	var prefix string
+
+	if k, ok := project.Lookup(kindName); ok {
+		if err := project.ValidateOptions(k, options); err != nil {
+			return &Application{}, err
+		}
+	}
+
 	cwd, err := os.Getwd()
 
 	if err != nil {
 		return &Application{}, err
 	}
 
+	if options.License != "" {
+		if err := base.ValidateSPDXLicense(options.License); err != nil {
+			return &Application{}, err
+		}
+	}
+
+	if options.BuildSystem == "" {
+		options.BuildSystem = base.BuildSystemMake
+	}
+
 	if options.PackageProject {
 		prefix = options.ProjectName
 		rootPath = cwd + "/package-" + options.ProjectName
@@ -143,13 +328,38 @@ func New(options base.ProjectOptions) (base.Project, error) {
 		rootPath = cwd + "/" + options.ProjectName
 	}
 
+	sources := createSources(options, rootPath, prefix)
+	formats := packageFormats(options)
+	archs := targetArchitectures(options)
+
 	application := &Application{
 		rootPath:       rootPath,
-		sources:        createSources(options, rootPath, prefix),
+		sources:        sources,
 		headers:        createHeaders(options, rootPath, prefix),
-		debian:         common.CreateDebianScripts(options, rootPath, prefix),
+		misc:           createLicense(options, rootPath),
+		cmake:          createCMake(options, sources, rootPath, prefix, archs),
+		makefile:       createMakefile(options, sources, rootPath, prefix, archs),
 		ProjectOptions: options,
 	}
 
+	for _, arch := range archs {
+		if hasFormat(formats, base.PackageFormatDeb) {
+			application.debian = append(application.debian, common.CreateDebianScripts(options, rootPath, prefix, arch)...)
+			application.debian = append(application.debian, common.CreateDebianControlFiles(options, rootPath, prefix, arch)...)
+		}
+
+		if hasFormat(formats, base.PackageFormatApk) {
+			application.apk = append(application.apk, common.CreateApkScripts(options, rootPath, prefix, arch)...)
+		}
+
+		if hasFormat(formats, base.PackageFormatRpm) {
+			application.rpm = append(application.rpm, common.CreateRpmSpec(options, rootPath, prefix, arch)...)
+		}
+
+		if hasFormat(formats, base.PackageFormatArch) {
+			application.arch = append(application.arch, common.CreatePkgbuild(options, rootPath, prefix, arch)...)
+		}
+	}
+
 	return application, nil
 }
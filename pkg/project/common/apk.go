@@ -0,0 +1,49 @@
+package common
+
+import (
+	"fmt"
+
+	"source-template/pkg/base"
+)
+
+// apkScriptTemplate renders an Alpine APKBUILD maintainer script
+// skeleton (.pre-install/.post-install/.pre-upgrade/.post-upgrade/
+// .pre-deinstall/.post-deinstall).
+type apkScriptTemplate struct {
+	name string
+	arch string
+}
+
+func (a *apkScriptTemplate) Render() (string, error) {
+	return fmt.Sprintf("#!/bin/sh\nset -e\n\n# %s (%s)\n\nexit 0\n", a.name, a.arch), nil
+}
+
+// CreateApkScripts builds the maintainer script skeletons under
+// pkg_install/<arch>/apk for a project.
+func CreateApkScripts(options base.ProjectOptions, rootPath string, prefix string, arch string) []base.FileInfo {
+	var files []base.FileInfo
+
+	scripts := []string{
+		"pre-install",
+		"post-install",
+		"pre-upgrade",
+		"post-upgrade",
+		"pre-deinstall",
+		"post-deinstall",
+	}
+
+	for _, s := range scripts {
+		fileOptions := base.FileOptions{
+			ProjectOptions: options,
+			Name:           rootPath + "/pkg_install/" + arch + "/apk/." + s,
+			Mode:           0755,
+		}
+
+		files = append(files, base.FileInfo{
+			FileOptions:  fileOptions,
+			FileTemplate: &apkScriptTemplate{name: s, arch: arch},
+		})
+	}
+
+	return files
+}
@@ -0,0 +1,26 @@
+package common
+
+// rpmArch and pkgbuildArch translate this generator's Debian-style arch
+// tokens (amd64/i386/armhf/arm64) into the vocabulary rpmbuild/makepkg
+// actually accept. Unknown tokens pass through unchanged.
+var rpmArch = map[string]string{
+	"amd64": "x86_64",
+	"i386":  "i686",
+	"armhf": "armv7hl",
+	"arm64": "aarch64",
+}
+
+var pkgbuildArch = map[string]string{
+	"amd64": "x86_64",
+	"i386":  "i686",
+	"armhf": "armv7h",
+	"arm64": "aarch64",
+}
+
+func translateArch(table map[string]string, arch string) string {
+	if translated, ok := table[arch]; ok {
+		return translated
+	}
+
+	return arch
+}
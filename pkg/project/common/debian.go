@@ -0,0 +1,46 @@
+package common
+
+import (
+	"fmt"
+
+	"source-template/pkg/base"
+)
+
+// debianScriptTemplate renders a Debian maintainer script skeleton
+// (preinst/postinst/prerm/postrm).
+type debianScriptTemplate struct {
+	name string
+	arch string
+}
+
+func (d *debianScriptTemplate) Render() (string, error) {
+	return fmt.Sprintf("#!/bin/sh\nset -e\n\n# %s (%s)\n\nexit 0\n", d.name, d.arch), nil
+}
+
+// CreateDebianScripts builds the maintainer script skeletons under
+// pkg_install/<arch>/debian for a project.
+func CreateDebianScripts(options base.ProjectOptions, rootPath string, prefix string, arch string) []base.FileInfo {
+	var files []base.FileInfo
+
+	scripts := []string{
+		"preinst",
+		"postinst",
+		"prerm",
+		"postrm",
+	}
+
+	for _, s := range scripts {
+		fileOptions := base.FileOptions{
+			ProjectOptions: options,
+			Name:           rootPath + "/pkg_install/" + arch + "/debian/" + s,
+			Mode:           0755,
+		}
+
+		files = append(files, base.FileInfo{
+			FileOptions:  fileOptions,
+			FileTemplate: &debianScriptTemplate{name: s, arch: arch},
+		})
+	}
+
+	return files
+}
@@ -0,0 +1,160 @@
+package common
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"source-template/pkg/base"
+)
+
+// controlTemplate renders debian/control.
+type controlTemplate struct {
+	options base.ProjectOptions
+	arch    string
+}
+
+func (c *controlTemplate) Render() (string, error) {
+	meta := c.options.Debian
+
+	standardsVersion := meta.StandardsVersion
+	if standardsVersion == "" {
+		standardsVersion = "4.6.0"
+	}
+
+	var out string
+
+	out += fmt.Sprintf("Source: %s\n", c.options.ProjectName)
+	out += fmt.Sprintf("Section: %s\n", orDefault(meta.Section, "devel"))
+	out += fmt.Sprintf("Priority: %s\n", orDefault(meta.Priority, "optional"))
+	out += fmt.Sprintf("Maintainer: %s\n", meta.Maintainer)
+	out += fmt.Sprintf("Build-Depends: %s\n", joinOrDefault(meta.BuildDepends, "debhelper-compat (= 13)"))
+	out += fmt.Sprintf("Standards-Version: %s\n", standardsVersion)
+
+	if meta.Homepage != "" {
+		out += fmt.Sprintf("Homepage: %s\n", meta.Homepage)
+	}
+
+	out += "\n"
+	out += fmt.Sprintf("Package: %s\n", c.options.ProjectName)
+	out += fmt.Sprintf("Architecture: %s\n", c.arch)
+	out += fmt.Sprintf("Depends: %s\n", joinOrDefault(meta.Depends, "${shlibs:Depends}, ${misc:Depends}"))
+	out += fmt.Sprintf("Description: %s\n", orDefault(meta.ShortDescription, c.options.ProjectName))
+
+	if meta.LongDescription != "" {
+		for _, line := range strings.Split(meta.LongDescription, "\n") {
+			if line == "" {
+				out += " .\n"
+				continue
+			}
+
+			out += " " + line + "\n"
+		}
+	}
+
+	return out, nil
+}
+
+// changelogTemplate renders debian/changelog, seeded with a first
+// UNRELEASED entry.
+type changelogTemplate struct {
+	options base.ProjectOptions
+}
+
+func (c *changelogTemplate) Render() (string, error) {
+	return fmt.Sprintf("%s (0.1-1) UNRELEASED; urgency=medium\n\n  * Initial release.\n\n -- %s  %s\n",
+		c.options.ProjectName, c.options.Debian.Maintainer, time.Now().Format(time.RFC1123Z)), nil
+}
+
+// rulesTemplate renders a minimal debian/rules relying on debhelper.
+type rulesTemplate struct{}
+
+func (r *rulesTemplate) Render() (string, error) {
+	return "#!/usr/bin/make -f\n\n%:\n\tdh $@\n", nil
+}
+
+// compatTemplate renders debian/compat.
+type compatTemplate struct{}
+
+func (c *compatTemplate) Render() (string, error) {
+	return "13\n", nil
+}
+
+// copyrightTemplate renders debian/copyright in the DEP-5
+// machine-readable format.
+type copyrightTemplate struct {
+	options base.ProjectOptions
+}
+
+func (c *copyrightTemplate) Render() (string, error) {
+	var out string
+
+	out += "Format: https://www.debian.org/doc/packaging-manuals/copyright-format/1.0/\n"
+	out += fmt.Sprintf("Upstream-Name: %s\n", c.options.ProjectName)
+
+	if c.options.Debian.Homepage != "" {
+		out += fmt.Sprintf("Source: %s\n", c.options.Debian.Homepage)
+	}
+
+	copyright := strings.Join(c.options.Copyright, ", ")
+	if copyright == "" {
+		copyright = c.options.Debian.Maintainer
+	}
+
+	out += "\nFiles: *\n"
+	out += fmt.Sprintf("Copyright: %s\n", copyright)
+	out += fmt.Sprintf("License: %s\n", c.options.License)
+
+	return out, nil
+}
+
+func orDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+
+	return value
+}
+
+func joinOrDefault(values []string, fallback string) string {
+	if len(values) == 0 {
+		return fallback
+	}
+
+	return strings.Join(values, ", ")
+}
+
+// CreateDebianControlFiles builds the debian/control, debian/changelog,
+// debian/rules, debian/compat and debian/copyright tree under
+// pkg_install/<arch>/debian for a project.
+func CreateDebianControlFiles(options base.ProjectOptions, rootPath string, prefix string, arch string) []base.FileInfo {
+	dir := rootPath + "/pkg_install/" + arch + "/debian/"
+
+	files := []struct {
+		name     string
+		mode     os.FileMode
+		template base.FileTemplate
+	}{
+		{"control", base.DefaultFileMode, &controlTemplate{options: options, arch: arch}},
+		{"changelog", base.DefaultFileMode, &changelogTemplate{options: options}},
+		{"rules", 0755, &rulesTemplate{}},
+		{"compat", base.DefaultFileMode, &compatTemplate{}},
+		{"copyright", base.DefaultFileMode, &copyrightTemplate{options: options}},
+	}
+
+	var infos []base.FileInfo
+
+	for _, f := range files {
+		infos = append(infos, base.FileInfo{
+			FileOptions: base.FileOptions{
+				ProjectOptions: options,
+				Name:           dir + f.name,
+				Mode:           f.mode,
+			},
+			FileTemplate: f.template,
+		})
+	}
+
+	return infos
+}
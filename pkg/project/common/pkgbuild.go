@@ -0,0 +1,49 @@
+package common
+
+import (
+	"fmt"
+
+	"source-template/pkg/base"
+)
+
+// pkgbuildTemplate renders an Arch Linux PKGBUILD skeleton, including the
+// pre_install/post_install/pre_upgrade/post_upgrade/pre_remove/
+// post_remove install hooks.
+type pkgbuildTemplate struct {
+	options base.ProjectOptions
+	arch    string
+}
+
+func (p *pkgbuildTemplate) Render() (string, error) {
+	var out string
+
+	out += fmt.Sprintf("pkgname=%s\npkgver=0.1\npkgrel=1\npkgdesc=\"\"\narch=('%s')\nlicense=('%s')\n\n",
+		p.options.ProjectName, translateArch(pkgbuildArch, p.arch), p.options.License)
+
+	out += "package() {\n    :\n}\n\n"
+
+	out += "pre_install() {\n    :\n}\n\n"
+	out += "post_install() {\n    :\n}\n\n"
+	out += "pre_upgrade() {\n    :\n}\n\n"
+	out += "post_upgrade() {\n    :\n}\n\n"
+	out += "pre_remove() {\n    :\n}\n\n"
+	out += "post_remove() {\n    :\n}\n"
+
+	return out, nil
+}
+
+// CreatePkgbuild builds the PKGBUILD skeleton under pkg_install/<arch>/arch
+// for a project.
+func CreatePkgbuild(options base.ProjectOptions, rootPath string, prefix string, arch string) []base.FileInfo {
+	fileOptions := base.FileOptions{
+		ProjectOptions: options,
+		Name:           rootPath + "/pkg_install/" + arch + "/arch/PKGBUILD",
+	}
+
+	return []base.FileInfo{
+		{
+			FileOptions:  fileOptions,
+			FileTemplate: &pkgbuildTemplate{options: options, arch: arch},
+		},
+	}
+}
@@ -0,0 +1,47 @@
+package common
+
+import (
+	"fmt"
+
+	"source-template/pkg/base"
+)
+
+// rpmSpecTemplate renders an RPM .spec file skeleton, including the
+// %pre/%post/%preun/%postun maintainer script sections.
+type rpmSpecTemplate struct {
+	options base.ProjectOptions
+	arch    string
+}
+
+func (r *rpmSpecTemplate) Render() (string, error) {
+	var out string
+
+	out += fmt.Sprintf("Name: %s\nVersion: 0.1\nRelease: 1%%{?dist}\nSummary: %s\n\n",
+		r.options.ProjectName, r.options.ProjectName)
+	out += fmt.Sprintf("License: %s\nBuildArch: %s\n\n%%description\n\n", r.options.License, translateArch(rpmArch, r.arch))
+
+	out += "%pre\nexit 0\n\n"
+	out += "%post\nexit 0\n\n"
+	out += "%preun\nexit 0\n\n"
+	out += "%postun\nexit 0\n\n"
+
+	out += "%files\n%license LICENSE\n"
+
+	return out, nil
+}
+
+// CreateRpmSpec builds the .spec file skeleton under pkg_install/<arch>/rpm
+// for a project.
+func CreateRpmSpec(options base.ProjectOptions, rootPath string, prefix string, arch string) []base.FileInfo {
+	fileOptions := base.FileOptions{
+		ProjectOptions: options,
+		Name:           rootPath + "/pkg_install/" + arch + "/rpm/" + options.ProjectName + ".spec",
+	}
+
+	return []base.FileInfo{
+		{
+			FileOptions:  fileOptions,
+			FileTemplate: &rpmSpecTemplate{options: options, arch: arch},
+		},
+	}
+}
@@ -0,0 +1,101 @@
+package project
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"source-template/pkg/base"
+)
+
+// Factory creates a new Project of a given kind from options.
+type Factory func(base.ProjectOptions) (base.Project, error)
+
+// Field documents one base.ProjectOptions field a project kind
+// understands, so the registry can reject options it doesn't advertise
+// and the CLI can render per-kind help.
+type Field struct {
+	Name        string
+	Description string
+}
+
+// Kind is what a project type registers with the registry, typically
+// from its own init().
+type Kind struct {
+	Name   string
+	New    Factory
+	Fields []Field
+}
+
+// commonFields lists the base.ProjectOptions fields every project kind
+// understands, regardless of what it registers in its own Fields.
+var commonFields = map[string]bool{
+	"ProjectName":    true,
+	"Author":         true,
+	"Email":          true,
+	"PackageProject": true,
+}
+
+var registry = map[string]Kind{}
+
+// Register adds a project kind to the registry. Panics on a duplicate
+// name, since that can only be a programming error at init() time.
+func Register(kind Kind) {
+	if _, exists := registry[kind.Name]; exists {
+		panic(fmt.Sprintf("project: kind %q already registered", kind.Name))
+	}
+
+	registry[kind.Name] = kind
+}
+
+// Lookup returns the project kind registered under name.
+func Lookup(name string) (Kind, bool) {
+	kind, ok := registry[name]
+	return kind, ok
+}
+
+// List returns the names of every registered project kind, sorted, for
+// use in --help output.
+func List() []string {
+	var names []string
+
+	for name := range registry {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// ValidateOptions rejects any base.ProjectOptions field that was set but
+// that kind doesn't advertise in its Fields, so a library project can't
+// silently ignore, say, TargetArchitectures meant for an application.
+func ValidateOptions(kind Kind, options base.ProjectOptions) error {
+	allowed := map[string]bool{}
+
+	for name := range commonFields {
+		allowed[name] = true
+	}
+
+	for _, f := range kind.Fields {
+		allowed[f.Name] = true
+	}
+
+	v := reflect.ValueOf(options)
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if allowed[field.Name] {
+			continue
+		}
+
+		if !v.Field(i).IsZero() {
+			return fmt.Errorf("project kind %q does not support option %q", kind.Name, field.Name)
+		}
+	}
+
+	return nil
+}
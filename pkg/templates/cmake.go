@@ -0,0 +1,58 @@
+package templates
+
+import (
+	"fmt"
+	"strings"
+
+	"source-template/pkg/base"
+)
+
+// cmakeTemplate renders the project's top-level CMakeLists.txt.
+type cmakeTemplate struct {
+	options  base.ProjectOptions
+	sources  []base.FileInfo
+	rootPath string
+	prefix   string
+	archs    []string
+}
+
+// NewCMake creates the FileTemplate for the project's CMakeLists.txt. The
+// file wires add_executable() to the same source list createSources
+// attaches to the Makefile build, so both build systems stay in sync.
+// archs is the list of target architectures to emit package-<arch>
+// cross-compiling targets for.
+func NewCMake(options base.ProjectOptions, sources []base.FileInfo, rootPath string, prefix string, archs []string) base.FileTemplate {
+	return &cmakeTemplate{options: options, sources: sources, rootPath: rootPath, prefix: prefix, archs: archs}
+}
+
+func (c *cmakeTemplate) Render() (string, error) {
+	var names []string
+
+	for _, f := range c.sources {
+		names = append(names, strings.TrimPrefix(f.Name, c.rootPath+"/"))
+	}
+
+	var out string
+
+	includeDir := joinPath(c.prefix, "include")
+
+	out += fmt.Sprintf("cmake_minimum_required(VERSION 3.10)\nproject(%s C)\n\n", c.options.ProjectName)
+	out += fmt.Sprintf("add_executable(%s\n    %s\n)\n\n", c.options.ProjectName, strings.Join(names, "\n    "))
+	out += fmt.Sprintf("target_include_directories(%s PRIVATE %s)\n\n", c.options.ProjectName, includeDir)
+
+	out += "install(TARGETS " + c.options.ProjectName + " RUNTIME DESTINATION usr/bin)\n"
+	out += fmt.Sprintf("install(DIRECTORY %s/ DESTINATION usr/include/%s)\n\n",
+		includeDir, c.options.ProjectName)
+
+	out += "enable_testing()\n\n"
+
+	for _, arch := range c.archs {
+		host := archToolchain[arch]
+
+		out += fmt.Sprintf("add_custom_target(package-%s\n", arch)
+		out += fmt.Sprintf("    COMMAND ${CMAKE_COMMAND} -S ${CMAKE_SOURCE_DIR} -B ${CMAKE_BINARY_DIR}/%s -DCMAKE_C_COMPILER=%s-gcc\n", arch, host)
+		out += fmt.Sprintf("    COMMAND ${CMAKE_COMMAND} --build ${CMAKE_BINARY_DIR}/%s\n)\n\n", arch)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,39 @@
+package templates
+
+import (
+	"fmt"
+	"strings"
+
+	"source-template/pkg/base"
+)
+
+// joinPath joins non-empty path segments with "/", so an empty prefix
+// (non-packaged projects lay their sources directly under rootPath)
+// doesn't turn into a leading "/" that looks like an absolute path.
+func joinPath(parts ...string) string {
+	var nonEmpty []string
+
+	for _, p := range parts {
+		if p != "" {
+			nonEmpty = append(nonEmpty, p)
+		}
+	}
+
+	return strings.Join(nonEmpty, "/")
+}
+
+// headerComment renders the standard file banner shared by every
+// generated source and header file. When a License was set it also
+// carries the SPDX-License-Identifier tag so downstream scanners can
+// pick up the file's license without parsing the full banner.
+func headerComment(options base.FileOptions) string {
+	out := fmt.Sprintf("/*\n * Project: %s\n", options.ProjectName)
+
+	if options.License != "" {
+		out += fmt.Sprintf(" *\n * SPDX-License-Identifier: %s\n", options.License)
+	}
+
+	out += " */\n\n"
+
+	return out
+}
@@ -0,0 +1,37 @@
+package templates
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"source-template/pkg/base"
+)
+
+// headerTemplate renders a C header file, guarding it with the usual
+// include-guard idiom.
+type headerTemplate struct {
+	options base.FileOptions
+	body    string
+}
+
+// NewHeader creates the FileTemplate used for a project's .h files. body
+// is placed inside the include guard as-is, and may be empty.
+func NewHeader(options base.FileOptions, body string) base.FileTemplate {
+	return &headerTemplate{options: options, body: body}
+}
+
+func (h *headerTemplate) Render() (string, error) {
+	var out string
+
+	if h.options.HeaderComment {
+		out += headerComment(h.options)
+	}
+
+	name := strings.TrimSuffix(filepath.Base(h.options.Name), ".h")
+	guard := strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_H"
+
+	out += fmt.Sprintf("#ifndef %s\n#define %s\n\n%s\n#endif /* %s */\n", guard, guard, h.body, guard)
+
+	return out, nil
+}
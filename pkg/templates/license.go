@@ -0,0 +1,72 @@
+package templates
+
+import (
+	"fmt"
+	"strings"
+
+	"source-template/pkg/base"
+)
+
+// licenseTemplate renders the project's LICENSE file.
+type licenseTemplate struct {
+	options base.ProjectOptions
+}
+
+// NewLicense creates the FileTemplate for the project's LICENSE file,
+// based on options.License (an SPDX identifier).
+func NewLicense(options base.ProjectOptions) base.FileTemplate {
+	return &licenseTemplate{options: options}
+}
+
+func (l *licenseTemplate) Render() (string, error) {
+	var out string
+
+	if len(l.options.Copyright) > 0 {
+		out += strings.Join(l.options.Copyright, "\n") + "\n\n"
+	}
+
+	switch l.options.License {
+	case "MIT":
+		out += mitLicenseBody
+	case "Apache-2.0":
+		out += apache2LicenseBody
+	default:
+		out += fmt.Sprintf(
+			"This project is licensed under the %s license.\n\nSee https://spdx.org/licenses/%s.html for the full license text.\n",
+			l.options.License, l.options.License)
+	}
+
+	return out, nil
+}
+
+const mitLicenseBody = `Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+`
+
+const apache2LicenseBody = `Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+`
@@ -0,0 +1,63 @@
+package templates
+
+import (
+	"fmt"
+	"strings"
+
+	"source-template/pkg/base"
+)
+
+// archToolchain maps a target architecture to the cross-compiler triple
+// its package-<arch> target prefixes onto CC.
+var archToolchain = map[string]string{
+	"amd64": "x86_64-linux-gnu",
+	"i386":  "i686-linux-gnu",
+	"armhf": "arm-linux-gnueabihf",
+	"arm64": "aarch64-linux-gnu",
+}
+
+// makefileTemplate renders the project's top-level Makefile.
+type makefileTemplate struct {
+	options  base.ProjectOptions
+	sources  []base.FileInfo
+	rootPath string
+	prefix   string
+	archs    []string
+}
+
+// NewMakefile creates the FileTemplate for the project's Makefile. archs
+// is the list of target architectures to emit cross-compile toggles and
+// package-<arch> targets for.
+func NewMakefile(options base.ProjectOptions, sources []base.FileInfo, rootPath string, prefix string, archs []string) base.FileTemplate {
+	return &makefileTemplate{options: options, sources: sources, rootPath: rootPath, prefix: prefix, archs: archs}
+}
+
+func (m *makefileTemplate) Render() (string, error) {
+	var names []string
+
+	for _, f := range m.sources {
+		names = append(names, strings.TrimPrefix(f.Name, m.rootPath+"/"))
+	}
+
+	var out string
+
+	out += fmt.Sprintf("PROJECT = %s\n", m.options.ProjectName)
+	out += fmt.Sprintf("SOURCES = %s\n", strings.Join(names, " "))
+	out += fmt.Sprintf("INCLUDE = %s\n\n", joinPath(m.prefix, "include"))
+
+	out += "CC := gcc\n"
+	out += "CFLAGS += -I$(INCLUDE)\n\n"
+
+	out += "$(PROJECT): $(SOURCES)\n\t$(CC) $(CFLAGS) -o $@ $(SOURCES)\n\n"
+
+	out += "clean:\n\trm -f $(PROJECT)\n\n"
+
+	for _, arch := range m.archs {
+		host := archToolchain[arch]
+
+		out += fmt.Sprintf("package-%s: CC = %s-gcc\n", arch, host)
+		out += fmt.Sprintf("package-%s: $(PROJECT)\n\n", arch)
+	}
+
+	return out, nil
+}
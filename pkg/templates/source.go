@@ -0,0 +1,30 @@
+package templates
+
+import (
+	"fmt"
+
+	"source-template/pkg/base"
+)
+
+// sourceTemplate renders a C source file.
+type sourceTemplate struct {
+	options base.FileOptions
+}
+
+// NewSource creates the FileTemplate used for a project's .c files.
+func NewSource(options base.FileOptions) base.FileTemplate {
+	return &sourceTemplate{options: options}
+}
+
+func (s *sourceTemplate) Render() (string, error) {
+	var out string
+
+	if s.options.HeaderComment {
+		out += headerComment(s.options)
+	}
+
+	out += fmt.Sprintf("#include \"%s.h\"\n\nint main(int argc, char **argv)\n{\n    return 0;\n}\n",
+		s.options.ProjectName)
+
+	return out, nil
+}